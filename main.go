@@ -0,0 +1,286 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/muesli/termenv"
+	"golang.org/x/term"
+
+	"github.com/IIpho3nix/lds/pkg/lds"
+	"github.com/IIpho3nix/lds/pkg/tui"
+)
+
+var logger = log.NewWithOptions(os.Stderr, log.Options{
+	ReportTimestamp: true,
+	TimeFormat:      time.Kitchen,
+})
+
+func main() {
+	opts := &lds.Options{}
+	format := "text"
+	interactive := false
+	colorMode := "auto"
+	showStats := false
+
+	if env := os.Getenv("LS_COLORS"); env != "" {
+		opts.LSColors = lds.ParseLSColors(env)
+	}
+
+	args := os.Args[1:]
+	paths := []string{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--help" || arg == "-h" {
+			fmt.Println("Usage: lds [options] [path ...]")
+			fmt.Println("Options:")
+			fmt.Println("  -a            Show hidden files.")
+			fmt.Println("  -l 			 Show long listing.")
+			fmt.Println("  -r            Reverse order.")
+			fmt.Println("  -L            Follow symlinks.")
+			fmt.Println("  --no-symlink  Do not follow symlinks.")
+			fmt.Println("  -d            List directories only.")
+			fmt.Println("  -P <pattern>  List only files matching pattern.")
+			fmt.Println("  -I <pattern>  Do not list files matching pattern.")
+			fmt.Println("  --matchdirs   Apply -P/-I patterns to directory names too.")
+			fmt.Println("  --regex       Treat -P/-I patterns as regular expressions.")
+			fmt.Println("  --prune       Omit directories left empty by filtering.")
+			fmt.Println("  --level <n>   Descend at most n directories deep (tree(1) calls this -L;")
+			fmt.Println("                lds' -L already means \"follow symlinks\", so this is long-form only).")
+			fmt.Println("  -J            Emit JSON instead of the styled tree.")
+			fmt.Println("  -X            Emit XML instead of the styled tree.")
+			fmt.Println("  -H            Emit a self-contained HTML page instead of the styled tree.")
+			fmt.Println("  -i            Browse the tree interactively and print the selected path.")
+			fmt.Println("  -F            Classify entries by appending /, @, *, |, or =.")
+			fmt.Println("  --color=MODE  auto (default), always, or never.")
+			fmt.Println("  -j [n]        Walk directories concurrently (default: NumCPU workers).")
+			fmt.Println("  --stats       Print a directories/files/size/time summary at the end.")
+			fmt.Println("  --sort=KEY    name (default), size, mtime, ctime, version, or none.")
+			fmt.Println("  -t            Shortcut for --sort=mtime.")
+			fmt.Println("  -S            Shortcut for --sort=size.")
+			fmt.Println("  --dirsfirst   List directories before files.")
+			fmt.Println("  --filesfirst  List files before directories.")
+			fmt.Println("  --du          Show each directory's recursive size, not its inode size.")
+			fmt.Println("  --human       Show long-format sizes as ls -h does (K/M/G/T).")
+			return
+		}
+
+		if arg == "--interactive" {
+			interactive = true
+			continue
+		}
+
+		if arg == "--stats" {
+			showStats = true
+			continue
+		}
+
+		if arg == "-j" {
+			opts.Workers = runtime.NumCPU()
+			if i+1 < len(args) {
+				if n, err := strconv.Atoi(args[i+1]); err == nil {
+					opts.Workers = n
+					i++
+				}
+			}
+			continue
+		}
+
+		if strings.HasPrefix(arg, "--color=") {
+			colorMode = strings.TrimPrefix(arg, "--color=")
+			switch colorMode {
+			case "auto", "always", "never":
+			default:
+				logger.Fatalf("Invalid --color value: %s", colorMode)
+			}
+			continue
+		}
+
+		if arg == "--no-symlink" {
+			opts.NoSymlink = true
+			continue
+		}
+
+		if arg == "--matchdirs" {
+			opts.MatchDirs = true
+			continue
+		}
+
+		if arg == "--prune" {
+			opts.Prune = true
+			continue
+		}
+
+		if arg == "--regex" {
+			opts.Regex = true
+			continue
+		}
+
+		if strings.HasPrefix(arg, "--sort=") {
+			sortBy := strings.TrimPrefix(arg, "--sort=")
+			switch sortBy {
+			case "name", "size", "mtime", "ctime", "version", "none":
+			default:
+				logger.Fatalf("Invalid --sort value: %s", sortBy)
+			}
+			opts.SortBy = sortBy
+			continue
+		}
+
+		if arg == "--dirsfirst" {
+			opts.DirsFirst = true
+			continue
+		}
+
+		if arg == "--filesfirst" {
+			opts.FilesFirst = true
+			continue
+		}
+
+		if arg == "--du" {
+			opts.Du = true
+			continue
+		}
+
+		if arg == "--human" {
+			opts.Human = true
+			continue
+		}
+
+		if arg == "--level" {
+			i++
+			if i >= len(args) {
+				logger.Fatalf("--level requires a value")
+			}
+			n, err := strconv.Atoi(args[i])
+			if err != nil {
+				logger.Fatalf("Invalid --level value: %v", err)
+			}
+			opts.MaxDepth = n
+			continue
+		}
+
+		if arg == "-P" || arg == "-I" {
+			i++
+			if i >= len(args) {
+				logger.Fatalf("%s requires a pattern", arg)
+			}
+			if arg == "-P" {
+				opts.IncludePattern = args[i]
+			} else {
+				opts.ExcludePattern = args[i]
+			}
+			continue
+		}
+
+		if strings.HasPrefix(arg, "-") && len(arg) > 1 {
+			for _, ch := range arg[1:] {
+				switch ch {
+				case 'a':
+					opts.ShowHidden = true
+				case 'l':
+					opts.LongFormat = true
+				case 'r':
+					opts.Reverse = true
+				case 'L':
+					opts.DerefLinks = true
+				case 'd':
+					opts.DirsOnly = true
+				case 'J':
+					format = "json"
+				case 'X':
+					format = "xml"
+				case 'H':
+					format = "html"
+				case 'i':
+					interactive = true
+				case 'F':
+					opts.Classify = true
+				case 't':
+					opts.SortBy = "mtime"
+				case 'S':
+					opts.SortBy = "size"
+				default:
+					logger.Fatalf("Unknown option: -%c", ch)
+				}
+			}
+			continue
+		}
+
+		paths = append(paths, arg)
+	}
+
+	if len(paths) == 0 {
+		paths = append(paths, ".")
+	}
+
+	switch colorMode {
+	case "always":
+		opts.NoColor = false
+		lipgloss.SetColorProfile(termenv.TrueColor)
+	case "never":
+		opts.NoColor = true
+	default:
+		opts.NoColor = !term.IsTerminal(int(os.Stdout.Fd()))
+	}
+
+	if interactive {
+		node := lds.New(paths[0])
+		selected, err := tui.Run(node, opts)
+		if err != nil {
+			logger.Fatalf("Error running interactive browser: %v", err)
+		}
+		if selected != "" {
+			fmt.Println(selected)
+		}
+		return
+	}
+
+	start := time.Now()
+	var total lds.Stats
+
+	for i, root := range paths {
+		if format == "text" && len(paths) > 1 {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Println(root + ":")
+		}
+
+		node := lds.New(root)
+		if err := node.Visit(opts); err != nil {
+			logger.Warnf("Error listing %s: %v", root, err)
+			continue
+		}
+
+		var err error
+		switch format {
+		case "json":
+			err = node.WriteJSON(os.Stdout)
+		case "xml":
+			err = node.WriteXML(os.Stdout)
+		case "html":
+			err = node.WriteHTML(os.Stdout)
+		default:
+			node.Print(opts, os.Stdout)
+		}
+		if err != nil {
+			logger.Warnf("Error rendering %s: %v", root, err)
+		}
+
+		total.Add(node.Stats())
+	}
+
+	if showStats && format == "text" {
+		fmt.Printf("\n%d directories, %d files, %d symlinks, %d bytes, %s\n",
+			total.Dirs, total.Files, total.Symlinks, total.Bytes, time.Since(start).Round(time.Millisecond))
+	}
+}
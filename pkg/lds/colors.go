@@ -0,0 +1,169 @@
+package lds
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LSColors is a parsed LS_COLORS table, mapping dircolors categories
+// (di, ln, ex, or, ...) and extension globs (*.tar, ...) to an SGR
+// code, the same format GNU ls and dircolors use.
+type LSColors struct {
+	byType map[string]string
+	byExt  map[string]string
+}
+
+// ParseLSColors parses a colon-separated LS_COLORS value such as
+// "di=01;34:ln=01;36:*.tar=01;31". Malformed entries are skipped.
+func ParseLSColors(value string) *LSColors {
+	lc := &LSColors{byType: map[string]string{}, byExt: map[string]string{}}
+
+	for _, entry := range strings.Split(value, ":") {
+		key, sgr, ok := strings.Cut(entry, "=")
+		if !ok || key == "" || sgr == "" {
+			continue
+		}
+		if strings.HasPrefix(key, "*") {
+			lc.byExt[strings.ToLower(key[1:])] = sgr
+		} else {
+			lc.byType[key] = sgr
+		}
+	}
+
+	return lc
+}
+
+// StyleFor looks up the style for n: first by file-type category
+// (di/ln/or/ex/fi), then by extension glob. The second result is
+// false when LS_COLORS has no matching entry, so callers can fall
+// back to their own default palette.
+func (lc *LSColors) StyleFor(n *Node) (lipgloss.Style, bool) {
+	if sgr, ok := lc.byType[typeCategory(n)]; ok {
+		return sgrToStyle(sgr), true
+	}
+
+	if ext := strings.ToLower(filepath.Ext(n.Name())); ext != "" {
+		if sgr, ok := lc.byExt[ext]; ok {
+			return sgrToStyle(sgr), true
+		}
+	}
+
+	return lipgloss.Style{}, false
+}
+
+func typeCategory(n *Node) string {
+	switch {
+	case n.Info != nil && n.Info.IsDir():
+		return "di"
+	case n.IsSymlink:
+		if n.Broken {
+			return "or"
+		}
+		return "ln"
+	case n.Info != nil && n.Info.Mode()&0o111 != 0:
+		return "ex"
+	default:
+		return "fi"
+	}
+}
+
+// sgrToStyle translates a semicolon-separated SGR code (as found in
+// LS_COLORS) into the equivalent lipgloss.Style.
+func sgrToStyle(sgr string) lipgloss.Style {
+	style := lipgloss.NewStyle()
+
+	parts := strings.Split(sgr, ";")
+	for i := 0; i < len(parts); i++ {
+		code, err := strconv.Atoi(parts[i])
+		if err != nil {
+			continue
+		}
+		switch code {
+		case 1:
+			style = style.Bold(true)
+		case 4:
+			style = style.Underline(true)
+		case 5:
+			style = style.Blink(true)
+		case 7:
+			style = style.Reverse(true)
+		case 38, 48:
+			fg := code == 38
+			if color, consumed, ok := parseSGRColor(parts[i+1:]); ok {
+				if fg {
+					style = style.Foreground(color)
+				} else {
+					style = style.Background(color)
+				}
+				i += consumed
+			}
+		default:
+			style = applyAnsiCode(style, code)
+		}
+	}
+
+	return style
+}
+
+// parseSGRColor parses the "5;N" (256-color) or "2;R;G;B" (truecolor)
+// tail of a 38/48 SGR sequence, returning how many of parts it used.
+func parseSGRColor(parts []string) (lipgloss.Color, int, bool) {
+	if len(parts) >= 2 && parts[0] == "5" {
+		return lipgloss.Color(parts[1]), 2, true
+	}
+	if len(parts) >= 4 && parts[0] == "2" {
+		r, _ := strconv.Atoi(parts[1])
+		g, _ := strconv.Atoi(parts[2])
+		b, _ := strconv.Atoi(parts[3])
+		return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", r, g, b)), 4, true
+	}
+	return "", 0, false
+}
+
+func applyAnsiCode(style lipgloss.Style, code int) lipgloss.Style {
+	switch {
+	case code >= 30 && code <= 37:
+		return style.Foreground(lipgloss.Color(strconv.Itoa(code - 30)))
+	case code >= 90 && code <= 97:
+		return style.Foreground(lipgloss.Color(strconv.Itoa(code - 90 + 8)))
+	case code >= 40 && code <= 47:
+		return style.Background(lipgloss.Color(strconv.Itoa(code - 40)))
+	case code >= 100 && code <= 107:
+		return style.Background(lipgloss.Color(strconv.Itoa(code - 100 + 8)))
+	}
+	return style
+}
+
+// styleName renders displayName for n, preferring LS_COLORS when set,
+// then falling back to lds' built-in palette, and finally to plain
+// text when color is disabled.
+func (o *Options) styleName(n *Node, displayName string, hidden bool) string {
+	if o.NoColor {
+		return displayName
+	}
+
+	if o.LSColors != nil {
+		if style, ok := o.LSColors.StyleFor(n); ok {
+			return style.Render(displayName)
+		}
+	}
+
+	return defaultStyle(n, hidden).Render(displayName)
+}
+
+func defaultStyle(n *Node, hidden bool) lipgloss.Style {
+	switch {
+	case n.Info != nil && n.Info.IsDir():
+		return DirStyle
+	case hidden:
+		return HiddenStyle
+	case n.IsSymlink:
+		return LinkStyle
+	default:
+		return FileStyle
+	}
+}
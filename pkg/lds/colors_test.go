@@ -0,0 +1,46 @@
+package lds
+
+import "testing"
+
+func TestParseLSColors(t *testing.T) {
+	lc := ParseLSColors("di=01;34:ln=01;36:*.tar=01;31:malformed:=skip:noequals")
+
+	if lc.byType["di"] != "01;34" {
+		t.Errorf("di = %q, want 01;34", lc.byType["di"])
+	}
+	if lc.byType["ln"] != "01;36" {
+		t.Errorf("ln = %q, want 01;36", lc.byType["ln"])
+	}
+	if lc.byExt[".tar"] != "01;31" {
+		t.Errorf("*.tar = %q, want 01;31", lc.byExt[".tar"])
+	}
+	if _, ok := lc.byType["malformed"]; ok {
+		t.Error("malformed entry with no '=' should be skipped")
+	}
+}
+
+func TestStyleFor(t *testing.T) {
+	lc := ParseLSColors("di=01;34:*.tar=01;31")
+
+	dir := &Node{Path: "sub", Info: fakeInfo{name: "sub", dir: true}}
+	if _, ok := lc.StyleFor(dir); !ok {
+		t.Error("directory should match the di category")
+	}
+
+	tarball := &Node{Path: "a.tar", Info: fakeInfo{name: "a.tar"}}
+	if _, ok := lc.StyleFor(tarball); !ok {
+		t.Error("a.tar should match the *.tar extension entry")
+	}
+
+	other := &Node{Path: "a.go", Info: fakeInfo{name: "a.go"}}
+	if _, ok := lc.StyleFor(other); ok {
+		t.Error("a.go should not match any entry in this table")
+	}
+}
+
+func TestSgrToStyle(t *testing.T) {
+	// sgrToStyle shouldn't panic on any of the SGR forms LS_COLORS uses.
+	for _, sgr := range []string{"01;34", "1", "38;5;208", "48;2;10;20;30", "7"} {
+		sgrToStyle(sgr)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build linux
+
+package lds
+
+import (
+	"syscall"
+	"time"
+)
+
+// ctime returns n's inode change time on Linux, falling back to its
+// modification time if the underlying Sys value isn't a
+// *syscall.Stat_t (e.g. a Node built over a non-OS Fs).
+func ctime(n *Node) time.Time {
+	if n.Info == nil {
+		return time.Time{}
+	}
+	if st, ok := n.Info.Sys().(*syscall.Stat_t); ok {
+		return time.Unix(st.Ctim.Sec, st.Ctim.Nsec)
+	}
+	return n.Info.ModTime()
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+
+package lds
+
+import "time"
+
+// ctime falls back to modification time on platforms where lds
+// doesn't know how to read the inode change time out of Sys.
+func ctime(n *Node) time.Time {
+	if n.Info == nil {
+		return time.Time{}
+	}
+	return n.Info.ModTime()
+}
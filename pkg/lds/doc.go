@@ -0,0 +1,54 @@
+package lds
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// docNode is the machine-readable representation of a Node, shared by
+// the JSON and XML writers.
+type docNode struct {
+	XMLName xml.Name `json:"-" xml:"node"`
+
+	Type     string     `json:"type" xml:"type,attr"`
+	Name     string     `json:"name" xml:"name,attr"`
+	Size     int64      `json:"size" xml:"size,attr"`
+	Mode     string     `json:"mode" xml:"mode,attr"`
+	ModTime  string     `json:"mtime" xml:"mtime,attr"`
+	Target   string     `json:"target,omitempty" xml:"target,attr,omitempty"`
+	Contents []*docNode `json:"contents,omitempty" xml:"node,omitempty"`
+}
+
+func buildDoc(n *Node) *docNode {
+	d := &docNode{
+		Type: docType(n),
+		Name: n.Name(),
+	}
+
+	if n.Info != nil {
+		d.Size = n.Info.Size()
+		d.Mode = n.Info.Mode().String()
+		d.ModTime = n.Info.ModTime().Format(time.RFC3339)
+	}
+
+	if n.IsSymlink {
+		d.Target = n.LinkTarget
+	}
+
+	for _, child := range n.Children {
+		d.Contents = append(d.Contents, buildDoc(child))
+	}
+
+	return d
+}
+
+func docType(n *Node) string {
+	switch {
+	case n.IsSymlink:
+		return "link"
+	case n.Info != nil && n.Info.IsDir():
+		return "directory"
+	default:
+		return "file"
+	}
+}
@@ -0,0 +1,52 @@
+package lds
+
+import "fmt"
+
+// computeDu populates DuSize across n's already-populated tree: a
+// file's DuSize is its own size, and a directory's is the sum of its
+// children's, computed bottom-up so each parent is summed only after
+// all of its children are.
+func (n *Node) computeDu() int64 {
+	if n.Info == nil || !n.Info.IsDir() {
+		if n.Info != nil {
+			n.DuSize = n.Info.Size()
+		}
+		return n.DuSize
+	}
+
+	var total int64
+	for _, child := range n.Children {
+		total += child.computeDu()
+	}
+	n.DuSize = total
+	return total
+}
+
+// sizeForDisplay returns the size n should be rendered with: its
+// recursive DuSize when opts.Du is set and n is a directory, otherwise
+// its own inode size.
+func (n *Node) sizeForDisplay(opts *Options) int64 {
+	if opts.Du && n.Info != nil && n.Info.IsDir() {
+		return n.DuSize
+	}
+	if n.Info == nil {
+		return 0
+	}
+	return n.Info.Size()
+}
+
+// humanSize formats size the way ls -h does: a 1024-based K/M/G/T/P/E
+// suffix with one decimal place, or the plain byte count below 1024.
+func humanSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%c", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,51 @@
+package lds
+
+import "testing"
+
+func TestComputeDu(t *testing.T) {
+	root := &Node{
+		Path: ".",
+		Info: fakeInfo{name: ".", dir: true},
+		Children: []*Node{
+			{Path: "a.txt", Info: fakeInfo{name: "a.txt", size: 10}},
+			{
+				Path: "sub",
+				Info: fakeInfo{name: "sub", dir: true},
+				Children: []*Node{
+					{Path: "sub/b.txt", Info: fakeInfo{name: "b.txt", size: 20}},
+					{Path: "sub/c.txt", Info: fakeInfo{name: "c.txt", size: 30}},
+				},
+			},
+		},
+	}
+
+	if got := root.computeDu(); got != 60 {
+		t.Errorf("root computeDu() = %d, want 60", got)
+	}
+	if root.Children[1].DuSize != 50 {
+		t.Errorf("sub DuSize = %d, want 50", root.Children[1].DuSize)
+	}
+	if root.Children[0].DuSize != 10 {
+		t.Errorf("a.txt DuSize = %d, want 10", root.Children[0].DuSize)
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	tests := []struct {
+		size int64
+		want string
+	}{
+		{0, "0"},
+		{1023, "1023"},
+		{1024, "1.0K"},
+		{1536, "1.5K"},
+		{1024 * 1024, "1.0M"},
+		{1024 * 1024 * 1024, "1.0G"},
+	}
+
+	for _, tt := range tests {
+		if got := humanSize(tt.size); got != tt.want {
+			t.Errorf("humanSize(%d) = %q, want %q", tt.size, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package lds
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// matchPattern reports whether name matches pattern. When regex is
+// set, pattern is a single regular expression - which may use its own
+// "|" alternation - matched as-is. Otherwise pattern may hold several
+// shell globs (see path/filepath.Match) separated by "|", since
+// filepath.Match has no alternation of its own.
+func matchPattern(pattern, name string, regex bool) bool {
+	if regex {
+		ok, err := regexp.MatchString(pattern, name)
+		return err == nil && ok
+	}
+
+	for _, part := range strings.Split(pattern, "|") {
+		if part == "" {
+			continue
+		}
+		if ok, _ := filepath.Match(part, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// passesFilter reports whether an entry belongs in the walked tree.
+// Directories are kept unconditionally unless MatchDirs is set,
+// because otherwise a matching file nested further down could never
+// be reached.
+func passesFilter(name string, isDir bool, opts *Options) bool {
+	if isDir && !opts.MatchDirs {
+		return true
+	}
+	if !isDir && opts.DirsOnly {
+		return false
+	}
+	if opts.ExcludePattern != "" && matchPattern(opts.ExcludePattern, name, opts.Regex) {
+		return false
+	}
+	if opts.IncludePattern != "" && !matchPattern(opts.IncludePattern, name, opts.Regex) {
+		return false
+	}
+	return true
+}
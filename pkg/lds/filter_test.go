@@ -0,0 +1,48 @@
+package lds
+
+import "testing"
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		target  string
+		regex   bool
+		want    bool
+	}{
+		{"single glob match", "*.go", "main.go", false, true},
+		{"single glob no match", "*.go", "main.py", false, false},
+		{"glob alternatives", "*.go|*.md", "README.md", false, true},
+		{"glob alternatives no match", "*.go|*.md", "data.json", false, false},
+		{"empty alternative is skipped", "*.go|", "main.go", false, true},
+		{"plain regex", "^main\\.go$", "main.go", true, true},
+		{"regex with its own alternation", `.*\.(txt|log)$`, "b.log", true, true},
+		{"regex with its own alternation no match", `.*\.(txt|log)$`, "c.md", true, false},
+		{"invalid regex never matches", "(", "main.go", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchPattern(tt.pattern, tt.target, tt.regex); got != tt.want {
+				t.Errorf("matchPattern(%q, %q, %v) = %v, want %v", tt.pattern, tt.target, tt.regex, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassesFilter(t *testing.T) {
+	opts := &Options{IncludePattern: "*.go", ExcludePattern: "*_test.go"}
+
+	if !passesFilter("main.go", false, opts) {
+		t.Error("main.go should pass the include pattern")
+	}
+	if passesFilter("main_test.go", false, opts) {
+		t.Error("main_test.go should be rejected by the exclude pattern")
+	}
+	if passesFilter("README.md", false, opts) {
+		t.Error("README.md should fail to match the include pattern")
+	}
+	if !passesFilter("sub", true, opts) {
+		t.Error("directories should pass unconditionally when MatchDirs is unset")
+	}
+}
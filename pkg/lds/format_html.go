@@ -0,0 +1,94 @@
+package lds
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+const htmlHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { background: #1d1f21; color: #c5c8c6; font-family: ui-monospace, monospace; }
+ul { list-style: none; padding-left: 1.25rem; margin: 0; }
+li { white-space: nowrap; }
+.lds-dir { color: #4e9a06; }
+.lds-file { color: #729fcf; }
+.lds-hidden { color: #888a85; }
+.lds-link { color: #ad7fa8; }
+</style>
+</head>
+<body>
+`
+
+const htmlFooter = `</body>
+</html>
+`
+
+// WriteHTML renders the tree rooted at n as a self-contained HTML
+// page, a <ul>-based outline using the same color palette as the
+// styled text output.
+func (n *Node) WriteHTML(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, htmlHeader, html.EscapeString(n.Name())); err != nil {
+		return err
+	}
+
+	if err := n.writeHTML(w); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, htmlFooter)
+	return err
+}
+
+func (n *Node) writeHTML(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "<span class=%q>%s</span>\n", htmlClass(n), html.EscapeString(htmlLabel(n))); err != nil {
+		return err
+	}
+
+	if len(n.Children) == 0 {
+		return nil
+	}
+
+	if _, err := io.WriteString(w, "<ul>\n"); err != nil {
+		return err
+	}
+	for _, child := range n.Children {
+		if _, err := io.WriteString(w, "<li>"); err != nil {
+			return err
+		}
+		if err := child.writeHTML(w); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "</li>\n"); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "</ul>\n")
+	return err
+}
+
+func htmlClass(n *Node) string {
+	switch {
+	case n.Info != nil && n.Info.IsDir():
+		return "lds-dir"
+	case strings.HasPrefix(n.Name(), "."):
+		return "lds-hidden"
+	case n.IsSymlink:
+		return "lds-link"
+	default:
+		return "lds-file"
+	}
+}
+
+func htmlLabel(n *Node) string {
+	if n.IsSymlink && n.LinkTarget != "" {
+		return n.Name() + " -> " + n.LinkTarget
+	}
+	return n.Name()
+}
@@ -0,0 +1,14 @@
+package lds
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// WriteJSON renders the tree rooted at n as a JSON document, so lds
+// output can be consumed by scripts and CI pipelines.
+func (n *Node) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(buildDoc(n))
+}
@@ -0,0 +1,28 @@
+package lds
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type xmlDoc struct {
+	XMLName xml.Name `xml:"tree"`
+	Root    *docNode
+}
+
+// WriteXML renders the tree rooted at n as a well-formed <tree>
+// document, so lds output can be consumed by scripts and CI pipelines.
+func (n *Node) WriteXML(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(xmlDoc{Root: buildDoc(n)}); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,31 @@
+package lds
+
+import (
+	"io/fs"
+	"os"
+)
+
+// OsFs implements Fs against the real filesystem via the os package.
+// It is the default Fs used when Options.Fs is nil.
+type OsFs struct{}
+
+// Stat lstats path, so symlinks are reported as symlinks rather than
+// being followed.
+func (OsFs) Stat(path string) (fs.FileInfo, error) {
+	return os.Lstat(path)
+}
+
+// ReadDir returns the names of path's directory entries, unsorted.
+func (OsFs) ReadDir(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	names, err := f.Readdirnames(0)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
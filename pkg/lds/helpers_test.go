@@ -0,0 +1,29 @@
+package lds
+
+import (
+	"io/fs"
+	"time"
+)
+
+// fakeInfo is a minimal fs.FileInfo for tests that need to drive
+// sorting or sizing logic without touching a real filesystem.
+type fakeInfo struct {
+	name    string
+	dir     bool
+	size    int64
+	modTime time.Time
+}
+
+func (f fakeInfo) Name() string       { return f.name }
+func (f fakeInfo) Size() int64        { return f.size }
+func (f fakeInfo) ModTime() time.Time { return f.modTime }
+func (f fakeInfo) Sys() any           { return nil }
+
+func (f fakeInfo) Mode() fs.FileMode {
+	if f.dir {
+		return fs.ModeDir | 0o755
+	}
+	return 0o644
+}
+
+func (f fakeInfo) IsDir() bool { return f.dir }
@@ -0,0 +1,66 @@
+package lds
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// visitParallel populates n and its descendants the same way visit
+// does, but reads directories across opts.Workers goroutines bounded
+// by a semaphore, for trees where synchronous recursion - e.g. over a
+// network filesystem - is the bottleneck. Each directory is owned by
+// exactly one goroutine at a time, so no Node is ever written to
+// concurrently. Like visit, a directory that fails to read has its
+// error recorded on its own Node and the walk continues with its
+// siblings; errgroup.WithContext is used only to wait for every
+// goroutine it spawned, not to abort the walk early, since nothing in
+// buildChildren is fatal to the rest of the tree.
+func (n *Node) visitParallel(filesystem Fs, opts *Options) error {
+	sem := make(chan struct{}, opts.Workers)
+	g, ctx := errgroup.WithContext(context.Background())
+
+	var walk func(node *Node) error
+	walk = func(node *Node) error {
+		if !node.canDescend(opts) {
+			return nil
+		}
+
+		children, err := node.buildChildren(filesystem, opts)
+		if err != nil {
+			node.Err = err
+			return nil
+		}
+		node.Children = children
+
+		for _, child := range children {
+			child := child
+			if !child.canDescend(opts) {
+				continue
+			}
+
+			select {
+			case sem <- struct{}{}:
+				g.Go(func() error {
+					defer func() { <-sem }()
+					return walk(child)
+				})
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+				// Pool is saturated; do this one on the calling
+				// goroutine rather than growing it unboundedly.
+				if err := walk(child); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(n); err != nil {
+		return err
+	}
+	return g.Wait()
+}
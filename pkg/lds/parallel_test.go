@@ -0,0 +1,86 @@
+package lds
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestVisitParallelMatchesSerial(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":          &fstest.MapFile{Data: []byte("hello")},
+		"sub/b.txt":      &fstest.MapFile{Data: []byte("world!")},
+		"sub/deep/c.txt": &fstest.MapFile{Data: []byte("x")},
+		"sub2/d.txt":     &fstest.MapFile{Data: []byte("y")},
+		"sub2/e/f/g.txt": &fstest.MapFile{Data: []byte("z")},
+	}
+
+	serial := New(".")
+	if err := serial.Visit(&Options{Fs: mapFs{fsys}}); err != nil {
+		t.Fatalf("serial Visit() error = %v", err)
+	}
+
+	parallel := New(".")
+	if err := parallel.Visit(&Options{Fs: mapFs{fsys}, Workers: 4}); err != nil {
+		t.Fatalf("parallel Visit() error = %v", err)
+	}
+
+	assertSameTree(t, serial, parallel)
+
+	serialStats, parallelStats := serial.Stats(), parallel.Stats()
+	if serialStats != parallelStats {
+		t.Errorf("Stats() mismatch: serial = %+v, parallel = %+v", serialStats, parallelStats)
+	}
+}
+
+func TestVisitParallelStatFailure(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":      &fstest.MapFile{Data: []byte("hello")},
+		"broken.txt": &fstest.MapFile{Data: []byte("x")},
+		"sub/c.txt":  &fstest.MapFile{Data: []byte("y")},
+	}
+
+	opts := &Options{
+		Fs:      failingStatFs{Fs: mapFs{fsys}, failPath: "broken.txt"},
+		Workers: 4,
+	}
+	root := New(".")
+	if err := root.Visit(opts); err != nil {
+		t.Fatalf("Visit() error = %v, want nil (the failure should be attached to the child node)", err)
+	}
+
+	var broken, sub *Node
+	for _, child := range root.Children {
+		switch child.Path {
+		case "broken.txt":
+			broken = child
+		case "sub":
+			sub = child
+		}
+	}
+	if broken == nil || broken.Err == nil || broken.Info != nil {
+		t.Fatalf("broken.txt = %+v, want Err set and Info nil", broken)
+	}
+	if sub == nil || len(sub.Children) != 1 {
+		t.Fatalf("sub should still be walked normally despite its sibling's Stat failure, got %+v", sub)
+	}
+}
+
+// assertSameTree compares two independently-walked trees structurally,
+// ignoring goroutine-order-dependent details neither walker guarantees
+// beyond what sortChildren already fixes.
+func assertSameTree(t *testing.T, a, b *Node) {
+	t.Helper()
+
+	if a.Path != b.Path {
+		t.Fatalf("path mismatch: %s != %s", a.Path, b.Path)
+	}
+	if len(a.Children) != len(b.Children) {
+		t.Fatalf("%s: child count mismatch: %d != %d", a.Path, len(a.Children), len(b.Children))
+	}
+	for i := range a.Children {
+		if a.Children[i].Name() != b.Children[i].Name() {
+			t.Fatalf("%s: child %d mismatch: %s != %s", a.Path, i, a.Children[i].Name(), b.Children[i].Name())
+		}
+		assertSameTree(t, a.Children[i], b.Children[i])
+	}
+}
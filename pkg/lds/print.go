@@ -0,0 +1,111 @@
+package lds
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// Print renders a walked tree to w in lds' default styled text format.
+func (n *Node) Print(opts *Options, w io.Writer) {
+	n.printNode(opts, w, "", true)
+	n.printChildren(opts, w, "")
+}
+
+func (n *Node) printChildren(opts *Options, w io.Writer, prefix string) {
+	for i, child := range n.Children {
+		isLast := i == len(n.Children)-1
+
+		linePrefix := prefix
+		if isLast {
+			linePrefix += branch
+		} else {
+			linePrefix += tee
+		}
+
+		child.printNode(opts, w, linePrefix, false)
+
+		if child.Err != nil {
+			continue
+		}
+
+		var childPrefix string
+		if isLast {
+			childPrefix = prefix + space
+		} else {
+			childPrefix = prefix + pipe
+		}
+		child.printChildren(opts, w, childPrefix)
+	}
+}
+
+func (n *Node) printNode(opts *Options, w io.Writer, prefix string, isRoot bool) {
+	name := n.Name()
+	hidden := strings.HasPrefix(name, ".")
+
+	displayName := name
+	if opts.Classify {
+		displayName += classifySuffix(n)
+	}
+	if n.IsSymlink && n.LinkTarget != "" && !opts.NoSymlink && !opts.DerefLinks {
+		displayName += " -> " + n.LinkTarget
+	}
+
+	styledName := opts.styleName(n, displayName, hidden)
+
+	if n.Err != nil {
+		fmt.Fprintf(w, "%s%s %s\n", prefix, styledName, errorStyle.Render("["+n.Err.Error()+"]"))
+		return
+	}
+
+	if isRoot {
+		fmt.Fprintln(w, styledName)
+		return
+	}
+
+	if opts.LongFormat {
+		perm := n.Info.Mode().String()
+		permCol := permStyle.Render(perm)
+
+		size := n.sizeForDisplay(opts)
+		var sizeStr string
+		if opts.Human {
+			sizeStr = fmt.Sprintf("%9s", humanSize(size))
+		} else {
+			sizeStr = fmt.Sprintf("%9d", size)
+		}
+		sizeCol := sizeStyle.Render(sizeStr)
+
+		modTime := n.Info.ModTime().Format("2006-01-02 15:04")
+		modTimeCol := modTimeStyle.Render(modTime)
+
+		fmt.Fprintf(w, "%s%s %s %s %s\n", prefix, styledName, permCol, sizeCol, modTimeCol)
+	} else {
+		fmt.Fprintf(w, "%s%s\n", prefix, styledName)
+	}
+}
+
+// classifySuffix returns the tree(1)/ls(1)-style single-character
+// type indicator for n: "/" for directories, "@" for symlinks, "*"
+// for executables, "|" for named pipes, and "=" for sockets.
+func classifySuffix(n *Node) string {
+	if n.Info == nil {
+		return ""
+	}
+
+	switch {
+	case n.Info.IsDir():
+		return "/"
+	case n.IsSymlink:
+		return "@"
+	case n.Info.Mode()&fs.ModeNamedPipe != 0:
+		return "|"
+	case n.Info.Mode()&fs.ModeSocket != 0:
+		return "="
+	case n.Info.Mode()&0o111 != 0:
+		return "*"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,131 @@
+package lds
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+func modTime(n *Node) time.Time {
+	if n.Info == nil {
+		return time.Time{}
+	}
+	return n.Info.ModTime()
+}
+
+func sortChildren(children []*Node, opts *Options) {
+	if opts.SortBy == "none" {
+		return
+	}
+
+	less := func(i, j int) bool {
+		a, b := children[i], children[j]
+
+		if opts.DirsFirst || opts.FilesFirst {
+			aDir := a.Info != nil && a.Info.IsDir()
+			bDir := b.Info != nil && b.Info.IsDir()
+			if aDir != bDir {
+				if opts.DirsFirst {
+					return aDir
+				}
+				return bDir
+			}
+		}
+
+		return sortLess(a, b, opts)
+	}
+
+	quickSort(children, 0, len(children)-1, less)
+}
+
+func sortLess(a, b *Node, opts *Options) bool {
+	switch opts.SortBy {
+	case "size":
+		as, bs := a.sizeForDisplay(opts), b.sizeForDisplay(opts)
+		if opts.Reverse {
+			return as > bs
+		}
+		return as < bs
+	case "mtime":
+		at, bt := modTime(a), modTime(b)
+		if opts.Reverse {
+			return at.After(bt)
+		}
+		return at.Before(bt)
+	case "ctime":
+		at, bt := ctime(a), ctime(b)
+		if opts.Reverse {
+			return at.After(bt)
+		}
+		return at.Before(bt)
+	case "version":
+		if opts.Reverse {
+			return versionLess(b.Name(), a.Name())
+		}
+		return versionLess(a.Name(), b.Name())
+	default: // "name", or unset
+		an, bn := strings.ToLower(a.Name()), strings.ToLower(b.Name())
+		if opts.Reverse {
+			return an > bn
+		}
+		return an < bn
+	}
+}
+
+// versionLess compares a and b the way GNU sort -V and tree --sort=version
+// do: runs of digits compare numerically, everything else byte-by-byte.
+func versionLess(a, b string) bool {
+	ai, bi := 0, 0
+	for ai < len(a) && bi < len(b) {
+		ac, bc := a[ai], b[bi]
+
+		if isDigit(ac) && isDigit(bc) {
+			as := ai
+			for ai < len(a) && isDigit(a[ai]) {
+				ai++
+			}
+			bs := bi
+			for bi < len(b) && isDigit(b[bi]) {
+				bi++
+			}
+
+			an, _ := strconv.Atoi(a[as:ai])
+			bn, _ := strconv.Atoi(b[bs:bi])
+			if an != bn {
+				return an < bn
+			}
+			continue
+		}
+
+		if ac != bc {
+			return ac < bc
+		}
+		ai++
+		bi++
+	}
+	return len(a)-ai < len(b)-bi
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func quickSort(entries []*Node, low, high int, less func(i, j int) bool) {
+	if low < high {
+		p := partition(entries, low, high, less)
+		quickSort(entries, low, p-1, less)
+		quickSort(entries, p+1, high, less)
+	}
+}
+
+func partition(entries []*Node, low, high int, less func(i, j int) bool) int {
+	i := low
+	for j := low; j < high; j++ {
+		if less(j, high) {
+			entries[i], entries[j] = entries[j], entries[i]
+			i++
+		}
+	}
+	entries[i], entries[high] = entries[high], entries[i]
+	return i
+}
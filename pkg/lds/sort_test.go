@@ -0,0 +1,59 @@
+package lds
+
+import "testing"
+
+func TestVersionLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"file2", "file10", true},
+		{"file10", "file2", false},
+		{"file2", "file2", false},
+		{"a", "b", true},
+		{"img9.png", "img10.png", true},
+		{"v1.2.0", "v1.10.0", true},
+	}
+
+	for _, tt := range tests {
+		if got := versionLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("versionLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSortChildren(t *testing.T) {
+	mk := func(name string, dir bool, size int64) *Node {
+		return &Node{Path: name, Info: fakeInfo{name: name, dir: dir, size: size}}
+	}
+
+	children := []*Node{
+		mk("b.txt", false, 100),
+		mk("sub", true, 0),
+		mk("a.txt", false, 10),
+	}
+
+	sortChildren(children, &Options{SortBy: "name"})
+	want := []string{"a.txt", "b.txt", "sub"}
+	for i, n := range children {
+		if n.Name() != want[i] {
+			t.Errorf("sort by name: position %d = %s, want %s", i, n.Name(), want[i])
+		}
+	}
+
+	sortChildren(children, &Options{SortBy: "size"})
+	want = []string{"sub", "a.txt", "b.txt"}
+	for i, n := range children {
+		if n.Name() != want[i] {
+			t.Errorf("sort by size: position %d = %s, want %s", i, n.Name(), want[i])
+		}
+	}
+
+	sortChildren(children, &Options{SortBy: "name", DirsFirst: true})
+	want = []string{"sub", "a.txt", "b.txt"}
+	for i, n := range children {
+		if n.Name() != want[i] {
+			t.Errorf("dirsfirst: position %d = %s, want %s", i, n.Name(), want[i])
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package lds
+
+// Stats summarizes a walked tree: how many directories, files, and
+// symlinks it holds, and their total size. The root itself is not
+// counted, only its descendants.
+type Stats struct {
+	Dirs     int
+	Files    int
+	Symlinks int
+	Bytes    int64
+}
+
+// Stats walks n's already-populated tree and tallies it up.
+func (n *Node) Stats() Stats {
+	var s Stats
+	for _, child := range n.Children {
+		child.addStats(&s)
+	}
+	return s
+}
+
+func (n *Node) addStats(s *Stats) {
+	if n.Err != nil {
+		return
+	}
+
+	switch {
+	case n.IsSymlink:
+		s.Symlinks++
+	case n.Info != nil && n.Info.IsDir():
+		s.Dirs++
+	default:
+		s.Files++
+		if n.Info != nil {
+			s.Bytes += n.Info.Size()
+		}
+	}
+
+	for _, child := range n.Children {
+		child.addStats(s)
+	}
+}
+
+// Add merges other into s, for combining stats across multiple roots.
+func (s *Stats) Add(other Stats) {
+	s.Dirs += other.Dirs
+	s.Files += other.Files
+	s.Symlinks += other.Symlinks
+	s.Bytes += other.Bytes
+}
@@ -0,0 +1,25 @@
+package lds
+
+import "github.com/charmbracelet/lipgloss"
+
+// DirStyle, FileStyle, HiddenStyle, and LinkStyle are exported so
+// other renderers - such as the interactive TUI - can stay visually
+// consistent with the static tree output.
+var (
+	DirStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#4e9a06")) // green
+	FileStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#729fcf")) // blue
+	HiddenStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#888a85")) // gray
+	LinkStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#ad7fa8")) // purple
+
+	permStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#f57900")) // orange
+	sizeStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#3465a4")) // darker blue
+	modTimeStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#cc0000")) // red
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#cc0000")).Bold(true)
+)
+
+const (
+	branch = "╰─ "
+	pipe   = "│  "
+	tee    = "├─ "
+	space  = "   "
+)
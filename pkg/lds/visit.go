@@ -0,0 +1,152 @@
+package lds
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Visit walks the filesystem rooted at n.Path, populating n and its
+// descendants according to opts. The root node's own Info is always
+// fetched; an error doing so is returned directly. Errors reading a
+// subdirectory further down the tree are attached to the offending
+// node's Err field instead, and the walk continues with its siblings.
+func (n *Node) Visit(opts *Options) error {
+	filesystem := opts.fs()
+
+	info, err := filesystem.Stat(n.Path)
+	if err != nil {
+		return err
+	}
+	n.Info = info
+
+	if opts.Workers > 1 {
+		if err := n.visitParallel(filesystem, opts); err != nil {
+			return err
+		}
+	} else {
+		n.visit(filesystem, opts)
+	}
+
+	if opts.Prune {
+		n.pruneEmpty()
+	}
+
+	if opts.Du {
+		n.computeDu()
+		// Children were sorted by size before their own DuSize was
+		// known; now that the whole subtree is summed, re-sort so
+		// --sort=size --du reflects the recursive totals it displays.
+		if opts.SortBy == "size" {
+			n.resort(opts)
+		}
+	}
+	return nil
+}
+
+func (n *Node) resort(opts *Options) {
+	sortChildren(n.Children, opts)
+	for _, child := range n.Children {
+		child.resort(opts)
+	}
+}
+
+func (n *Node) visit(filesystem Fs, opts *Options) {
+	if !n.canDescend(opts) {
+		return
+	}
+
+	children, err := n.buildChildren(filesystem, opts)
+	if err != nil {
+		n.Err = err
+		return
+	}
+
+	for _, child := range children {
+		child.visit(filesystem, opts)
+	}
+
+	n.Children = children
+}
+
+// canDescend reports whether n is a directory that hasn't yet hit
+// opts.MaxDepth, and so should have its own children read. Nodes whose
+// Info couldn't be fetched (Err is set instead) never descend.
+func (n *Node) canDescend(opts *Options) bool {
+	if n.Info == nil || !n.Info.IsDir() {
+		return false
+	}
+	return opts.MaxDepth <= 0 || n.Depth < opts.MaxDepth
+}
+
+// buildChildren reads and stats n's directory entries into a sorted,
+// filtered slice of children, without recursing into them. It is the
+// single-directory unit of work shared by the serial and parallel
+// walkers.
+func (n *Node) buildChildren(filesystem Fs, opts *Options) ([]*Node, error) {
+	names, err := filesystem.ReadDir(n.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]*Node, 0, len(names))
+	for _, name := range names {
+		if !opts.ShowHidden && strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		childPath := filepath.Join(n.Path, name)
+		info, err := filesystem.Stat(childPath)
+		if err != nil {
+			children = append(children, &Node{Path: childPath, Depth: n.Depth + 1, Err: err})
+			continue
+		}
+
+		if !passesFilter(name, info.IsDir(), opts) {
+			continue
+		}
+
+		child := &Node{Path: childPath, Depth: n.Depth + 1, Info: info}
+		child.IsSymlink = info.Mode()&fs.ModeSymlink != 0
+
+		if child.IsSymlink && opts.DerefLinks {
+			if target, err := filepath.EvalSymlinks(childPath); err == nil {
+				if targetInfo, err := filesystem.Stat(target); err == nil {
+					child.Info = targetInfo
+					child.Path = target
+					child.IsSymlink = false
+				}
+			}
+		} else if child.IsSymlink && !opts.NoSymlink {
+			if target, err := os.Readlink(childPath); err == nil {
+				child.LinkTarget = target
+			}
+			if _, err := os.Stat(childPath); err != nil {
+				child.Broken = true
+			}
+		}
+
+		children = append(children, child)
+	}
+
+	sortChildren(children, opts)
+	return children, nil
+}
+
+// pruneEmpty drops, bottom-up, any directory left with no children
+// once filtering is applied. Directories that failed to read keep
+// their Err and are never pruned.
+func (n *Node) pruneEmpty() {
+	kept := make([]*Node, 0, len(n.Children))
+	for _, child := range n.Children {
+		if child.Info != nil && child.Info.IsDir() {
+			child.pruneEmpty()
+			if len(child.Children) == 0 && child.Err == nil {
+				continue
+			}
+		}
+		kept = append(kept, child)
+	}
+	n.Children = kept
+}
@@ -0,0 +1,143 @@
+package lds
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+// mapFs adapts an fstest.MapFS to the Fs interface, so Visit can be
+// exercised against an in-memory tree instead of the real filesystem.
+type mapFs struct {
+	fsys fstest.MapFS
+}
+
+func (m mapFs) Stat(path string) (fs.FileInfo, error) {
+	return fs.Stat(m.fsys, path)
+}
+
+func (m mapFs) ReadDir(path string) ([]string, error) {
+	entries, err := fs.ReadDir(m.fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	return names, nil
+}
+
+func TestVisitMapFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":          &fstest.MapFile{Data: []byte("hello")},
+		"sub/b.txt":      &fstest.MapFile{Data: []byte("world!")},
+		"sub/.hidden":    &fstest.MapFile{Data: []byte("shh")},
+		"sub/deep/c.txt": &fstest.MapFile{Data: []byte("x")},
+	}
+
+	opts := &Options{Fs: mapFs{fsys}}
+	root := New(".")
+	if err := root.Visit(opts); err != nil {
+		t.Fatalf("Visit() error = %v", err)
+	}
+
+	if len(root.Children) != 2 {
+		t.Fatalf("root has %d children, want 2 (a.txt, sub)", len(root.Children))
+	}
+	if root.Children[0].Name() != "a.txt" || root.Children[1].Name() != "sub" {
+		t.Fatalf("children = [%s, %s], want [a.txt, sub]", root.Children[0].Name(), root.Children[1].Name())
+	}
+
+	sub := root.Children[1]
+	if len(sub.Children) != 2 {
+		t.Fatalf("sub has %d children, want 2 (b.txt, deep), hidden entry should be skipped", len(sub.Children))
+	}
+
+	stats := root.Stats()
+	if stats.Files != 3 || stats.Dirs != 2 {
+		t.Errorf("Stats() = %+v, want 3 files and 2 dirs (sub, sub/deep)", stats)
+	}
+}
+
+func TestVisitMapFSShowHidden(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sub/.hidden": &fstest.MapFile{Data: []byte("shh")},
+	}
+
+	opts := &Options{Fs: mapFs{fsys}, ShowHidden: true}
+	root := New("sub")
+	if err := root.Visit(opts); err != nil {
+		t.Fatalf("Visit() error = %v", err)
+	}
+
+	if len(root.Children) != 1 || root.Children[0].Name() != ".hidden" {
+		t.Fatalf("children = %v, want [.hidden] with ShowHidden set", root.Children)
+	}
+}
+
+// failingStatFs wraps an Fs and fails Stat for one specific path,
+// simulating an entry ReadDir reports but that can no longer be
+// stat'd - e.g. a permission change or a file removed mid-walk.
+type failingStatFs struct {
+	Fs
+	failPath string
+}
+
+func (f failingStatFs) Stat(path string) (fs.FileInfo, error) {
+	if path == f.failPath {
+		return nil, fmt.Errorf("stat %s: permission denied", path)
+	}
+	return f.Fs.Stat(path)
+}
+
+func TestVisitMapFSStatFailure(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":      &fstest.MapFile{Data: []byte("hello")},
+		"broken.txt": &fstest.MapFile{Data: []byte("x")},
+	}
+
+	opts := &Options{Fs: failingStatFs{Fs: mapFs{fsys}, failPath: "broken.txt"}}
+	root := New(".")
+	if err := root.Visit(opts); err != nil {
+		t.Fatalf("Visit() error = %v, want nil (the failure should be attached to the child node)", err)
+	}
+
+	if len(root.Children) != 2 {
+		t.Fatalf("root has %d children, want 2 (a.txt, broken.txt)", len(root.Children))
+	}
+
+	var broken *Node
+	for _, child := range root.Children {
+		if child.Path == "broken.txt" {
+			broken = child
+		}
+	}
+	if broken == nil {
+		t.Fatal("broken.txt missing from children")
+	}
+	if broken.Err == nil {
+		t.Error("broken.txt should have Err set")
+	}
+	if broken.Info != nil {
+		t.Error("broken.txt should have a nil Info")
+	}
+}
+
+func TestVisitMapFSMaxDepth(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sub/deep/c.txt": &fstest.MapFile{Data: []byte("x")},
+	}
+
+	opts := &Options{Fs: mapFs{fsys}, MaxDepth: 1}
+	root := New(".")
+	if err := root.Visit(opts); err != nil {
+		t.Fatalf("Visit() error = %v", err)
+	}
+
+	sub := root.Children[0]
+	if len(sub.Children) != 0 {
+		t.Errorf("sub.Children = %v, want none below MaxDepth", sub.Children)
+	}
+}
@@ -0,0 +1,249 @@
+// Package tui implements lds' interactive tree browser, built on
+// Bubble Tea. It reuses pkg/lds for walking and styling so the
+// interactive and static renderers stay visually consistent.
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/IIpho3nix/lds/pkg/lds"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Reverse(true)
+	helpStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#888a85"))
+)
+
+type row struct {
+	node  *lds.Node
+	depth int
+}
+
+// Model is a Bubble Tea model browsing the tree rooted at a *lds.Node,
+// lazily visiting directories as they're expanded.
+type Model struct {
+	root     *lds.Node
+	opts     *lds.Options
+	expanded map[string]bool
+	rows     []row
+	cursor   int
+
+	filtering bool
+	filter    string
+
+	selected string
+	quitting bool
+}
+
+// New builds a Model over root. opts controls how each directory is
+// visited as the user expands it; root itself is visited immediately.
+func New(root *lds.Node, opts *lds.Options) Model {
+	m := Model{
+		root:     root,
+		opts:     opts,
+		expanded: map[string]bool{root.Path: true},
+	}
+	m.visitLevel(root)
+	m.rebuild()
+	return m
+}
+
+// Run launches the interactive browser and returns the path the user
+// selected, or "" if they quit without selecting anything.
+func Run(root *lds.Node, opts *lds.Options) (string, error) {
+	result, err := tea.NewProgram(New(root, opts)).Run()
+	if err != nil {
+		return "", err
+	}
+	if m, ok := result.(Model); ok {
+		return m.selected, nil
+	}
+	return "", nil
+}
+
+func (m Model) Init() tea.Cmd { return nil }
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	if m.filtering {
+		switch keyMsg.Type {
+		case tea.KeyEnter, tea.KeyEsc:
+			m.filtering = false
+		case tea.KeyBackspace:
+			if len(m.filter) > 0 {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+		case tea.KeyRunes:
+			m.filter += string(keyMsg.Runes)
+		default:
+			return m, nil
+		}
+		m.rebuild()
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "q":
+		m.quitting = true
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.rows)-1 {
+			m.cursor++
+		}
+	case "enter", " ":
+		m.toggleCursor()
+		if m.quitting {
+			return m, tea.Quit
+		}
+	case "a":
+		m.opts.ShowHidden = !m.opts.ShowHidden
+		m.reloadExpanded(m.root)
+		m.rebuild()
+	case "L":
+		m.opts.DerefLinks = !m.opts.DerefLinks
+		m.reloadExpanded(m.root)
+		m.rebuild()
+	case "/":
+		m.filtering = true
+		m.filter = ""
+		m.rebuild()
+	}
+
+	return m, nil
+}
+
+func (m *Model) toggleCursor() {
+	if len(m.rows) == 0 {
+		return
+	}
+
+	n := m.rows[m.cursor].node
+	if n.Info != nil && n.Info.IsDir() {
+		m.expanded[n.Path] = !m.expanded[n.Path]
+		if m.expanded[n.Path] && len(n.Children) == 0 && n.Err == nil {
+			m.visitLevel(n)
+		}
+		m.rebuild()
+		return
+	}
+
+	m.selected = n.Path
+	m.quitting = true
+}
+
+// visitLevel (re-)visits n to populate exactly its direct children,
+// using the current options.
+func (m *Model) visitLevel(n *lds.Node) {
+	opts := *m.opts
+	opts.MaxDepth = n.Depth + 1
+	n.Visit(&opts)
+}
+
+func (m *Model) reloadExpanded(n *lds.Node) {
+	m.visitLevel(n)
+	if !m.expanded[n.Path] {
+		return
+	}
+	for _, child := range n.Children {
+		if child.Info != nil && child.Info.IsDir() {
+			m.reloadExpanded(child)
+		}
+	}
+}
+
+func (m *Model) rebuild() {
+	m.rows = m.rows[:0]
+
+	if m.filter != "" {
+		m.collectMatching(m.root, 0)
+	} else {
+		m.collectExpanded(m.root, 0)
+	}
+
+	if m.cursor >= len(m.rows) {
+		m.cursor = len(m.rows) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+func (m *Model) collectExpanded(n *lds.Node, depth int) {
+	m.rows = append(m.rows, row{node: n, depth: depth})
+	if n.Info != nil && n.Info.IsDir() && m.expanded[n.Path] {
+		for _, child := range n.Children {
+			m.collectExpanded(child, depth+1)
+		}
+	}
+}
+
+func (m *Model) collectMatching(n *lds.Node, depth int) {
+	if strings.Contains(strings.ToLower(n.Name()), strings.ToLower(m.filter)) {
+		m.rows = append(m.rows, row{node: n, depth: depth})
+	}
+	for _, child := range n.Children {
+		m.collectMatching(child, depth+1)
+	}
+}
+
+func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var b strings.Builder
+	for i, r := range m.rows {
+		marker := "  "
+		if r.node.Info != nil && r.node.Info.IsDir() {
+			if m.expanded[r.node.Path] {
+				marker = "▾ "
+			} else {
+				marker = "▸ "
+			}
+		}
+
+		name := r.node.Name()
+		if r.node.IsSymlink && r.node.LinkTarget != "" {
+			name += " -> " + r.node.LinkTarget
+		}
+
+		line := strings.Repeat("  ", r.depth) + marker + nodeStyle(r.node).Render(name)
+		if i == m.cursor {
+			line = cursorStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	if m.filtering {
+		b.WriteString(helpStyle.Render(fmt.Sprintf("search: %s", m.filter)))
+	} else {
+		b.WriteString(helpStyle.Render("↑/↓ navigate · enter/space expand · a hidden · L symlinks · / search · q quit"))
+	}
+
+	return b.String()
+}
+
+func nodeStyle(n *lds.Node) lipgloss.Style {
+	switch {
+	case n.Info != nil && n.Info.IsDir():
+		return lds.DirStyle
+	case strings.HasPrefix(n.Name(), "."):
+		return lds.HiddenStyle
+	case n.IsSymlink:
+		return lds.LinkStyle
+	default:
+		return lds.FileStyle
+	}
+}